@@ -3,6 +3,8 @@ package inject
 import (
 	"fmt"
 	"reflect"
+	"strings"
+	"sync"
 	"unsafe"
 )
 
@@ -22,6 +24,9 @@ type Injector interface {
 	TypeMapper
 	// SetParent 给 Injector 设置一个父 Injector
 	SetParent(Injector)
+	// Child 创建一个以当前 Injector 为父容器的子 Injector, 常用于给每个请求
+	// 分配一个独立的子容器, 使请求级别的绑定不会互相污染
+	Child() Injector
 }
 
 type Applicator interface {
@@ -31,8 +36,23 @@ type Applicator interface {
 }
 
 type Invoker interface {
-	// Invoke 给函数注入入参
+	// Invoke 给函数注入入参并调用一次, 每次都重新计算调用计划, 不会缓存 f, 适合
+	// f 本身就是按调用现场新分配的闭包(如按请求构造的 handler)的场景.
+	// 需要在热路径上反复调用同一个函数时, 改用 Plan 显式缓存调用计划.
 	Invoke(interface{}) ([]reflect.Value, error)
+	// Plan 预先计算函数的入参类型列表, 并缓存那些在计算时就已经能从静态 Map 的值中
+	// 解析出来的值(Provider 和 AutoApply 解析的类型不缓存), 返回的 InvokePlan 可以针对不同的 Injector 反复 Call, 只有仍然缺失的入参
+	// 才会在每次 Call 时重新反射解析, 适合路由器按请求高频调用同一个 handler 的场景.
+	// fn 按闭包身份被永久缓存在该 Injector 上, 不会被回收, 因此只应传入生命周期
+	// 与 Injector 相当的函数(如在启动时注册一次的 handler), 不要传入每次调用都
+	// 新分配的闭包.
+	Plan(fn interface{}) (InvokePlan, error)
+}
+
+// InvokePlan 是 Plan 预计算出的调用计划
+type InvokePlan interface {
+	// Call 使用给定的 Injector 补齐仍然缺失的入参并调用函数
+	Call(inj Injector) ([]reflect.Value, error)
 }
 
 type TypeMapper interface {
@@ -45,11 +65,162 @@ type TypeMapper interface {
 	Set(reflect.Type, reflect.Value) TypeMapper
 	// Get 从可注入列表中获取一个指定类型的可注入对象
 	Get(reflect.Type) reflect.Value
+	// MapNamed 将对象映射到指定名称的可注入列表, 用于同一类型存在多个取值的场景,
+	// 例如同为 *Address 类型的 "home" 和 "work" 两个实例
+	MapNamed(string, interface{}) TypeMapper
+	// GetNamed 按名称从可注入列表中获取一个可注入对象
+	GetNamed(string) reflect.Value
+	// MapProvider 注册一个构造函数, 首次被 Get/Apply/Invoke 用到时才会调用,
+	// 其返回值类型即为绑定的类型, 构造函数自身的入参同样会从容器中递归解析
+	MapProvider(fn interface{}, opts ...ProviderOption) TypeMapper
+}
+
+// Scope 描述 Provider 产生的对象的生命周期
+type Scope int
+
+const (
+	// Singleton 表示 Provider 只会被调用一次, 结果会被缓存并复用, 这是默认值
+	Singleton Scope = iota
+	// Transient 表示每次解析都会重新调用 Provider
+	Transient
+)
+
+// ProviderOption 用于在 MapProvider 时配置 Provider 的行为
+type ProviderOption func(*provider)
+
+// WithScope 指定 Provider 的生命周期
+func WithScope(scope Scope) ProviderOption {
+	return func(p *provider) {
+		p.scope = scope
+	}
+}
+
+type provider struct {
+	fn    reflect.Value
+	out   reflect.Type
+	scope Scope
+
+	// once 保证 Singleton 生命周期的 Provider 在并发场景下也只被调用一次
+	once  sync.Once
+	value reflect.Value
+	err   error
 }
 
 type injector struct {
+	mu     sync.RWMutex
 	values map[reflect.Type]reflect.Value
-	parent Injector
+	// order 记录 values 中类型键第一次被写入的顺序, 使接口的"第一个实现者"扫描
+	// 结果是确定性的, 而不是依赖 map 的随机遍历顺序
+	order     []reflect.Type
+	named     map[string]reflect.Value
+	providers map[reflect.Type]*provider
+	plans     map[uintptr]*invokePlan
+	parent    Injector
+	// autoApply 开启后, Get 在类型缺失时会为结构体类型自动 new 并 Apply, 见 WithAutoApply
+	autoApply bool
+	autoMu    sync.Mutex
+	// autoBuilds 记录每个类型正在或已经进行的自动构建, 见 autoResolve
+	autoBuilds map[reflect.Type]*autoBuild
+}
+
+// InjectorOption 用于在 New 时配置 Injector 的行为
+type InjectorOption func(*injector)
+
+// WithAutoApply 开启 AutoApply 模式: 遇到一个未被 Map/MapProvider 注册的结构体类型时,
+// 自动 new 一个实例并递归 Apply 其自身的 inject 字段, 免去逐层手动构建并 Map 依赖的麻烦
+func WithAutoApply() InjectorOption {
+	return func(inj *injector) {
+		inj.autoApply = true
+	}
+}
+
+// ResolutionError 描述 AutoApply 自动装配过程中检测到的循环依赖, Path 记录了
+// 从最外层被请求的类型到发现循环处的完整依赖链
+type ResolutionError struct {
+	Path []reflect.Type
+}
+
+func (e *ResolutionError) Error() string {
+	return fmt.Sprintf("inject: circular dependency while auto-resolving: %s", cyclePath(e.Path))
+}
+
+// invokePlan 是某个函数入参类型列表的预计算结果, argTypes[i] 对应的 slots[i] 有效
+// 表示该入参在 Plan 时就已经能从静态 Map 的值中解析出来, 可以在每次 Call 时直接复用
+type invokePlan struct {
+	fn       reflect.Value
+	argTypes []reflect.Type
+	slots    []reflect.Value
+}
+
+func (p *invokePlan) Call(inj Injector) ([]reflect.Value, error) {
+	in := make([]reflect.Value, len(p.argTypes))
+	for i, argType := range p.argTypes {
+		if p.slots[i].IsValid() {
+			in[i] = p.slots[i]
+			continue
+		}
+		val, err := resolveFrom(inj, argType)
+		if err != nil {
+			return nil, err
+		}
+		if !val.IsValid() {
+			return nil, fmt.Errorf("Value not found for type %v", argType)
+		}
+		in[i] = val
+	}
+	return p.fn.Call(in), nil
+}
+
+// resolveFrom 优先使用 *injector 的错误感知解析路径, 使 Provider 的错误
+// 能够穿透 Plan.Call 而不是被 Get 悄悄吞掉
+func resolveFrom(inj Injector, t reflect.Type) (reflect.Value, error) {
+	if ij, ok := inj.(*injector); ok {
+		return ij.resolveType(t, nil)
+	}
+	return inj.Get(t), nil
+}
+
+// Bind 是 MapTo 的泛型版本, 免去手写 (*Iface)(nil) 取类型的样板代码,
+// 例如 inject.Bind[SpecialString](inj, "男")
+func Bind[T any](inj Injector, val T) TypeMapper {
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	return inj.Set(t, reflect.ValueOf(val))
+}
+
+// BindAll 返回容器及其父容器链中所有实现了 T 的已注册值, 同一类型以离调用者最近的
+// 容器上的绑定为准(与 Get 的接口解析/作用域屏蔽规则一致), 顺序先是自身的注册顺序,
+// 再是父容器的. 可用于"注入所有实现了该接口的处理器"这类中间件场景, 配合 Child()
+// 给每个请求分配独立子容器时, 仍能取到注册在根容器上的处理器.
+func BindAll[T any](inj Injector) []T {
+	ij, ok := inj.(*injector)
+	if !ok {
+		return nil
+	}
+	t := reflect.TypeOf((*T)(nil)).Elem()
+
+	seen := make(map[reflect.Type]bool)
+	var result []T
+	for ij != nil {
+		ij.mu.RLock()
+		for _, k := range ij.order {
+			if seen[k] {
+				continue
+			}
+			seen[k] = true
+			if k.Implements(t) {
+				result = append(result, ij.values[k].Interface().(T))
+			}
+		}
+		parent := ij.parent
+		ij.mu.RUnlock()
+
+		pr, ok := parent.(*injector)
+		if !ok {
+			break
+		}
+		ij = pr
+	}
+	return result
 }
 
 // InterfaceOf 获取一个任意类型对象的反射类型
@@ -64,27 +235,43 @@ func InterfaceOf(value interface{}) reflect.Type {
 	return t
 }
 
-func New() Injector {
-	return &injector{
-		values: make(map[reflect.Type]reflect.Value),
+func New(opts ...InjectorOption) Injector {
+	inj := &injector{
+		values:     make(map[reflect.Type]reflect.Value),
+		named:      make(map[string]reflect.Value),
+		providers:  make(map[reflect.Type]*provider),
+		plans:      make(map[uintptr]*invokePlan),
+		autoBuilds: make(map[reflect.Type]*autoBuild),
+	}
+	for _, opt := range opts {
+		opt(inj)
 	}
+	return inj
 }
 
 func (inj *injector) Apply(val interface{}) error {
-	return inj.apply(reflect.ValueOf(val))
+	return inj.apply(reflect.ValueOf(val), nil)
 }
 
 func (inj *injector) ApplyAll() error {
-	var err error
+	inj.mu.RLock()
+	vals := make([]reflect.Value, 0, len(inj.values))
 	for _, v := range inj.values {
-		if err = inj.apply(v); err != nil {
+		vals = append(vals, v)
+	}
+	inj.mu.RUnlock()
+
+	for _, v := range vals {
+		if err := inj.apply(v, nil); err != nil {
 			return err
 		}
 	}
 	return nil
 }
 
-func (inj *injector) apply(v reflect.Value) error {
+// apply 为 v 的 inject 字段注入依赖. chain 记录当前正在自动装配中的类型,
+// 用于在 AutoApply 模式下检测 A->B->A 这样的循环依赖.
+func (inj *injector) apply(v reflect.Value, chain []reflect.Type) error {
 	for v.Kind() == reflect.Ptr {
 		v = v.Elem()
 	}
@@ -97,9 +284,20 @@ func (inj *injector) apply(v reflect.Value) error {
 		structField := t.Field(i)
 		if structField.Tag == "inject" ||
 			structField.Tag == "inject:\"\"" ||
-			structField.Tag.Get("inject") != "" {
+			structField.Tag.Get(tag) != "" {
 			ft := f.Type()
-			v := inj.Get(ft)
+			name := structField.Tag.Get(tag)
+			var v reflect.Value
+			if name != "" {
+				v = inj.GetNamed(name)
+			}
+			if !v.IsValid() {
+				var err error
+				v, err = inj.resolveType(ft, chain)
+				if err != nil {
+					return err
+				}
+			}
 			if !f.CanSet() {
 				nf := reflect.NewAt(f.Type(), unsafe.Pointer(f.UnsafeAddr())).Elem()
 				nf.Set(v)
@@ -114,22 +312,82 @@ func (inj *injector) apply(v reflect.Value) error {
 	return nil
 }
 
+// Invoke 直接为 f 构建一次性的调用计划并执行, 不写入 plans 缓存: f 在这里通常是
+// 每次调用都新分配的闭包(例如按请求构造的 handler), 若也按闭包身份缓存下去,
+// 缓存会无限增长并永久 pin 住每一个这样的闭包及其捕获的状态. 需要跨次复用调用计划
+// 的热路径场景应显式调用 Plan 自己持有并反复 Call 返回的 InvokePlan.
 func (inj *injector) Invoke(f interface{}) ([]reflect.Value, error) {
-	t := reflect.TypeOf(f)
-	in := make([]reflect.Value, t.NumIn())
-	for i := 0; i < t.NumIn(); i++ {
-		argType := t.In(i)
-		val := inj.Get(argType)
-		if !val.IsValid() {
-			return nil, fmt.Errorf("Value not found for type %v", argType)
+	p, err := inj.buildPlan(f)
+	if err != nil {
+		return nil, err
+	}
+	return p.Call(inj)
+}
+
+// closureIdentity 返回 fn 的一个对每个闭包实例都唯一的地址, 用作 Plan 的缓存 key.
+// reflect.Value.Pointer() 对 Func 类型返回的是底层代码的入口地址: 由同一个工厂函数
+// (例如 func NewHandler(svc *Service) http.HandlerFunc) 产生的不同闭包共享同一段
+// 代码, 会在该入口地址上互相碰撞. 这里改用 fn 装箱为 interface{} 后的数据字,
+// 它指向闭包捕获变量所在的 funcval, 每个闭包实例各自独立, 不会与其他实例冲突.
+func closureIdentity(fn interface{}) uintptr {
+	type iface struct {
+		typ  unsafe.Pointer
+		data unsafe.Pointer
+	}
+	return uintptr((*iface)(unsafe.Pointer(&fn)).data)
+}
+
+// buildPlan 计算 fn 的 InvokePlan, 不读写 inj.plans 缓存, 供 Plan(缓存)和
+// Invoke(不缓存)共用.
+func (inj *injector) buildPlan(fn interface{}) (*invokePlan, error) {
+	fv := reflect.ValueOf(fn)
+	if fv.Kind() != reflect.Func {
+		return nil, fmt.Errorf("inject.Plan: fn must be a function, got %v", fv.Kind())
+	}
+
+	ft := fv.Type()
+	p := &invokePlan{
+		fn:       fv,
+		argTypes: make([]reflect.Type, ft.NumIn()),
+		slots:    make([]reflect.Value, ft.NumIn()),
+	}
+	for i := 0; i < ft.NumIn(); i++ {
+		argType := ft.In(i)
+		p.argTypes[i] = argType
+		if val := inj.resolveStaticType(argType); val.IsValid() {
+			p.slots[i] = val
 		}
-		in[i] = val
 	}
-	return reflect.ValueOf(f).Call(in), nil
+	return p, nil
+}
+
+func (inj *injector) Plan(fn interface{}) (InvokePlan, error) {
+	fv := reflect.ValueOf(fn)
+	if fv.Kind() != reflect.Func {
+		return nil, fmt.Errorf("inject.Plan: fn must be a function, got %v", fv.Kind())
+	}
+	ptr := closureIdentity(fn)
+
+	inj.mu.RLock()
+	if cached, ok := inj.plans[ptr]; ok {
+		inj.mu.RUnlock()
+		return cached, nil
+	}
+	inj.mu.RUnlock()
+
+	p, err := inj.buildPlan(fn)
+	if err != nil {
+		return nil, err
+	}
+
+	inj.mu.Lock()
+	inj.plans[ptr] = p
+	inj.mu.Unlock()
+	return p, nil
 }
 
 func (inj *injector) Map(val interface{}) TypeMapper {
-	inj.values[reflect.TypeOf(val)] = reflect.ValueOf(val)
+	inj.setValue(reflect.TypeOf(val), reflect.ValueOf(val))
 	return inj
 }
 
@@ -141,35 +399,266 @@ func (inj *injector) Maps(vals ...interface{}) TypeMapper {
 }
 
 func (inj *injector) MapTo(val interface{}, ifacePtr interface{}) TypeMapper {
-	inj.values[InterfaceOf(ifacePtr)] = reflect.ValueOf(val)
+	inj.setValue(InterfaceOf(ifacePtr), reflect.ValueOf(val))
 	return inj
 }
 
 func (inj *injector) Set(typ reflect.Type, val reflect.Value) TypeMapper {
-	inj.values[typ] = val
+	inj.setValue(typ, val)
 	return inj
 }
 
-func (inj injector) Get(t reflect.Type) reflect.Value {
+// setValue 写入 values 并在类型键首次出现时记录其插入顺序
+func (inj *injector) setValue(t reflect.Type, val reflect.Value) {
+	inj.mu.Lock()
+	defer inj.mu.Unlock()
+	if _, exists := inj.values[t]; !exists {
+		inj.order = append(inj.order, t)
+	}
+	inj.values[t] = val
+}
+
+func (inj *injector) Get(t reflect.Type) reflect.Value {
+	val, _ := inj.resolveType(t, nil)
+	return val
+}
+
+// resolveStaticType 只在自身及父容器链的静态值列表(含接口的首个实现者)中查找 t,
+// 不触发 Provider 调用也不触发 AutoApply. Plan 用它来判断某个入参是否可以安全地
+// 冻结进 InvokePlan.slots: 只有确定来自静态 Map 的值才是安全的, 经 Provider(尤其是
+// Transient scope)或 AutoApply 解析出的值必须推迟到每次 Call 时重新解析.
+func (inj *injector) resolveStaticType(t reflect.Type) reflect.Value {
+	inj.mu.RLock()
 	val := inj.values[t]
+	if !val.IsValid() && t.Kind() == reflect.Interface {
+		for _, k := range inj.order {
+			if k.Implements(t) {
+				val = inj.values[k]
+				break
+			}
+		}
+	}
+	parent := inj.parent
+	inj.mu.RUnlock()
+
 	if val.IsValid() {
 		return val
 	}
-	if t.Kind() == reflect.Interface {
-		for k, v := range inj.values {
+	if parent != nil {
+		if pr, ok := parent.(*injector); ok {
+			return pr.resolveStaticType(t)
+		}
+	}
+	return reflect.Value{}
+}
+
+// resolveType 依次在自身的值列表, Provider 列表, 父容器中查找类型 t 对应的值,
+// 最后才考虑当前容器自身的 AutoApply. chain 记录当前正在解析中的 Provider 类型,
+// 用于检测 Provider 之间的循环依赖.
+func (inj *injector) resolveType(t reflect.Type, chain []reflect.Type) (reflect.Value, error) {
+	val, found, err := inj.resolveInherited(t, chain)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+	if found {
+		return val, nil
+	}
+	if inj.autoApply {
+		return inj.autoResolve(t, chain)
+	}
+	return reflect.Value{}, nil
+}
+
+// resolveInherited 在自身及父容器链中查找静态值或 Provider, 但不触发 AutoApply:
+// AutoApply 只应在最初发起解析的那个 Injector 上生效, 不能被"继承"给父容器链,
+// 否则子容器自身的覆盖绑定会被忽略, 自动构建出的实例还会被缓存到祖先容器上,
+// 在所有兄弟子容器间共享, 违背 Child() 请求级别隔离的初衷.
+func (inj *injector) resolveInherited(t reflect.Type, chain []reflect.Type) (reflect.Value, bool, error) {
+	inj.mu.RLock()
+	val := inj.values[t]
+	if !val.IsValid() && t.Kind() == reflect.Interface {
+		for _, k := range inj.order {
 			if k.Implements(t) {
-				val = v
+				val = inj.values[k]
 				break
 			}
 		}
 	}
+	p, hasProvider := inj.providers[t]
+	parent := inj.parent
+	inj.mu.RUnlock()
 
-	if !val.IsValid() && inj.parent != nil {
-		val = inj.parent.Get(t)
+	if val.IsValid() {
+		return val, true, nil
 	}
-	return val
+	if hasProvider {
+		v, err := inj.resolveProvider(p, chain)
+		return v, true, err
+	}
+	if parent != nil {
+		if pr, ok := parent.(*injector); ok {
+			return pr.resolveInherited(t, chain)
+		}
+		v := parent.Get(t)
+		return v, v.IsValid(), nil
+	}
+	return reflect.Value{}, false, nil
+}
+
+// autoBuild 记录 AutoApply 模式下某个类型的一次自动构建, once 保证并发的首次
+// Get/Apply 调用中只有一个真正执行构建, 其余调用等待并复用同一个结果, 见 autoResolve.
+type autoBuild struct {
+	once  sync.Once
+	value reflect.Value
+	err   error
+}
+
+// autoResolve 在 AutoApply 模式下为一个未注册的结构体类型自动构建实例:
+// new 一个实例, 递归 Apply 其自身的 inject 字段, 并将结果写回 values 缓存.
+// 同一类型的并发首次调用共享同一个 autoBuild, 只会构建一次.
+func (inj *injector) autoResolve(t reflect.Type, chain []reflect.Type) (reflect.Value, error) {
+	structType := t
+	if t.Kind() == reflect.Ptr {
+		structType = t.Elem()
+	}
+	if structType.Kind() != reflect.Struct {
+		return reflect.Value{}, nil
+	}
+
+	for _, seen := range chain {
+		if seen == t {
+			return reflect.Value{}, &ResolutionError{Path: append(append([]reflect.Type{}, chain...), t)}
+		}
+	}
+	chain = append(chain, t)
+
+	inj.autoMu.Lock()
+	b, ok := inj.autoBuilds[t]
+	if !ok {
+		b = &autoBuild{}
+		inj.autoBuilds[t] = b
+	}
+	inj.autoMu.Unlock()
+
+	b.once.Do(func() {
+		ptr := reflect.New(structType)
+		if err := inj.apply(ptr, chain); err != nil {
+			b.err = err
+			return
+		}
+
+		result := ptr
+		if t.Kind() != reflect.Ptr {
+			result = ptr.Elem()
+		}
+
+		inj.setValue(t, result)
+		b.value = result
+	})
+	return b.value, b.err
+}
+
+// resolveProvider 调用 Provider 生成一个值, Singleton 生命周期通过 sync.Once
+// 保证并发场景下也只会被调用一次.
+func (inj *injector) resolveProvider(p *provider, chain []reflect.Type) (reflect.Value, error) {
+	for _, seen := range chain {
+		if seen == p.out {
+			return reflect.Value{}, fmt.Errorf("inject: provider cycle detected: %s", cyclePath(append(chain, p.out)))
+		}
+	}
+	chain = append(chain, p.out)
+
+	call := func() (reflect.Value, error) {
+		ft := p.fn.Type()
+		in := make([]reflect.Value, ft.NumIn())
+		for i := 0; i < ft.NumIn(); i++ {
+			argType := ft.In(i)
+			val, err := inj.resolveType(argType, chain)
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			if !val.IsValid() {
+				return reflect.Value{}, fmt.Errorf("inject: value not found for provider argument %v (required by %v)", argType, p.out)
+			}
+			in[i] = val
+		}
+		out := p.fn.Call(in)
+		if len(out) > 1 && !out[1].IsNil() {
+			return reflect.Value{}, out[1].Interface().(error)
+		}
+		return out[0], nil
+	}
+
+	if p.scope == Transient {
+		return call()
+	}
+	p.once.Do(func() {
+		p.value, p.err = call()
+	})
+	return p.value, p.err
+}
+
+// cyclePath 将 Provider 循环依赖的类型链渲染为可读的错误信息
+func cyclePath(chain []reflect.Type) string {
+	names := make([]string, len(chain))
+	for i, t := range chain {
+		names[i] = t.String()
+	}
+	return strings.Join(names, " -> ")
 }
 
-func (inj injector) SetParent(parent Injector) {
+func (inj *injector) SetParent(parent Injector) {
+	inj.mu.Lock()
+	defer inj.mu.Unlock()
 	inj.parent = parent
 }
+
+// Child 创建一个新的子 Injector 并将其父容器设置为当前 Injector, AutoApply 设置会被继承
+func (inj *injector) Child() Injector {
+	child := New().(*injector)
+	child.autoApply = inj.autoApply
+	child.SetParent(inj)
+	return child
+}
+
+func (inj *injector) MapNamed(name string, val interface{}) TypeMapper {
+	inj.mu.Lock()
+	defer inj.mu.Unlock()
+	inj.named[name] = reflect.ValueOf(val)
+	return inj
+}
+
+func (inj *injector) GetNamed(name string) reflect.Value {
+	inj.mu.RLock()
+	val := inj.named[name]
+	parent := inj.parent
+	inj.mu.RUnlock()
+
+	if !val.IsValid() && parent != nil {
+		val = parent.GetNamed(name)
+	}
+	return val
+}
+
+func (inj *injector) MapProvider(fn interface{}, opts ...ProviderOption) TypeMapper {
+	fv := reflect.ValueOf(fn)
+	ft := fv.Type()
+	if ft.Kind() != reflect.Func || ft.NumOut() == 0 {
+		panic("inject.MapProvider: fn must be a function with at least one return value")
+	}
+	if ft.NumOut() == 2 && !ft.Out(1).Implements(reflect.TypeOf((*error)(nil)).Elem()) {
+		panic("inject.MapProvider: fn's second return value must be an error")
+	}
+	if ft.NumOut() > 2 {
+		panic("inject.MapProvider: fn must return (value) or (value, error)")
+	}
+	p := &provider{fn: fv, out: ft.Out(0), scope: Singleton}
+	for _, opt := range opts {
+		opt(p)
+	}
+
+	inj.mu.Lock()
+	defer inj.mu.Unlock()
+	inj.providers[p.out] = p
+	return inj
+}
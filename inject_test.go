@@ -2,7 +2,10 @@ package inject
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"reflect"
+	"sync"
 	"testing"
 )
 
@@ -49,3 +52,552 @@ func TestInject(t *testing.T) {
 	bytes, _ := json.Marshal(user)
 	fmt.Println(string(bytes))
 }
+
+type Company struct {
+	Home *Address `inject:"home"`
+	Work *Address `inject:"work"`
+}
+
+func TestInjectNamed(t *testing.T) {
+	var company Company
+	injector := New()
+	home := NewAddress("广东", "深圳", "宝安", "新安")
+	work := NewAddress("广东", "深圳", "南山", "科技园")
+	injector.MapNamed("home", home)
+	injector.MapNamed("work", work)
+
+	if err := injector.Apply(&company); err != nil {
+		t.Fatal(err)
+	}
+	if company.Home != home {
+		t.Errorf("expected home address %v, got %v", home, company.Home)
+	}
+	if company.Work != work {
+		t.Errorf("expected work address %v, got %v", work, company.Work)
+	}
+}
+
+func TestMapProviderSingleton(t *testing.T) {
+	calls := 0
+	injector := New()
+	injector.MapProvider(func() *Address {
+		calls++
+		return NewAddress("广东", "深圳", "宝安", "新安")
+	})
+
+	first := injector.Get(reflect.TypeOf(&Address{}))
+	second := injector.Get(reflect.TypeOf(&Address{}))
+	if calls != 1 {
+		t.Errorf("expected provider to be called once, got %d", calls)
+	}
+	if first.Interface() != second.Interface() {
+		t.Errorf("expected singleton provider to return the same instance")
+	}
+}
+
+func TestMapProviderTransient(t *testing.T) {
+	calls := 0
+	injector := New()
+	injector.MapProvider(func() *Address {
+		calls++
+		return NewAddress("广东", "深圳", "宝安", "新安")
+	}, WithScope(Transient))
+
+	first := injector.Get(reflect.TypeOf(&Address{}))
+	second := injector.Get(reflect.TypeOf(&Address{}))
+	if calls != 2 {
+		t.Errorf("expected transient provider to be called twice, got %d", calls)
+	}
+	if first.Interface() == second.Interface() {
+		t.Errorf("expected transient provider to return distinct instances")
+	}
+}
+
+func TestMapProviderChain(t *testing.T) {
+	injector := New()
+	injector.MapProvider(func() *AuthCode {
+		return &AuthCode{Code: "123456"}
+	})
+	injector.MapProvider(func(auth *AuthCode) *User {
+		return &User{Name: "chained", AuthCoed: auth}
+	})
+
+	val := injector.Get(reflect.TypeOf(&User{}))
+	if !val.IsValid() {
+		t.Fatal("expected chained provider to resolve *User")
+	}
+	user := val.Interface().(*User)
+	if user.AuthCoed == nil || user.AuthCoed.Code != "123456" {
+		t.Errorf("expected *User provider to receive resolved *AuthCode, got %v", user.AuthCoed)
+	}
+}
+
+func TestMapProviderError(t *testing.T) {
+	wantErr := errors.New("boom")
+	injector := New()
+	injector.MapProvider(func() (*Address, error) {
+		return nil, wantErr
+	})
+
+	err := injector.Apply(&struct {
+		Address *Address `inject`
+	}{})
+	if !errors.Is(err, wantErr) {
+		t.Errorf("expected provider error to propagate, got %v", err)
+	}
+}
+
+func TestMapProviderCycle(t *testing.T) {
+	injector := New()
+	injector.MapProvider(func(*User) *AuthCode { return &AuthCode{} })
+	injector.MapProvider(func(*AuthCode) *User { return &User{} })
+
+	_, err := injector.Invoke(func(*AuthCode) {})
+	if err == nil {
+		t.Fatal("expected a provider cycle error")
+	}
+}
+
+func TestChildInheritsFromParent(t *testing.T) {
+	root := New()
+	root.Map(&AuthCode{Code: "123456"})
+	root.Map(NewAddress("广东", "深圳", "宝安", "新安"))
+
+	child := root.Child()
+	var user User
+	if err := child.Apply(&user); err != nil {
+		t.Fatal(err)
+	}
+	if user.AuthCoed == nil || user.AuthCoed.Code != "123456" {
+		t.Errorf("expected child to resolve values mapped on its parent, got %v", user.AuthCoed)
+	}
+}
+
+func TestChildIsolatesOwnBindings(t *testing.T) {
+	root := New()
+	a := root.Child()
+	b := root.Child()
+	a.Map(NewAddress("广东", "深圳", "宝安", "新安"))
+
+	if a.Get(reflect.TypeOf(&Address{})).IsValid() == false {
+		t.Errorf("expected a's own binding to resolve")
+	}
+	if b.Get(reflect.TypeOf(&Address{})).IsValid() {
+		t.Errorf("expected sibling child not to see a's binding")
+	}
+}
+
+// TestConcurrentInvoke 在共享的根 Injector 上并发地为每个请求创建子 Injector 并 Invoke,
+// 用 -race 运行以验证 values/named/providers 在并发读写下是安全的.
+func TestConcurrentInvoke(t *testing.T) {
+	root := New()
+	root.Map(&AuthCode{Code: "123456"})
+	root.MapProvider(func(auth *AuthCode) *User {
+		return &User{Name: "request-scoped", AuthCoed: auth}
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 100; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			req := root.Child()
+			req.MapNamed("request-id", i)
+			if _, err := req.Invoke(func(user *User) {
+				if user.AuthCoed.Code != "123456" {
+					t.Errorf("unexpected auth code %q", user.AuthCoed.Code)
+				}
+			}); err != nil {
+				t.Errorf("invoke failed: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func TestPlanCallResolvesMissingArgsPerCall(t *testing.T) {
+	root := New()
+	root.Map(&AuthCode{Code: "123456"})
+	fn := func(auth *AuthCode, addr *Address) *User {
+		return &User{AuthCoed: auth, Address: addr}
+	}
+
+	plan, err := root.Plan(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := plan.Call(root); err == nil {
+		t.Fatal("expected error because *Address is not yet mapped")
+	}
+
+	addr := NewAddress("广东", "深圳", "宝安", "新安")
+	child := root.Child()
+	child.Map(addr)
+
+	out, err := plan.Call(child)
+	if err != nil {
+		t.Fatal(err)
+	}
+	user := out[0].Interface().(*User)
+	if user.Address != addr {
+		t.Errorf("expected plan.Call to resolve *Address freshly from the given Injector")
+	}
+	if user.AuthCoed.Code != "123456" {
+		t.Errorf("expected plan.Call to reuse the *AuthCode slot cached at Plan time")
+	}
+}
+
+func TestPlanDoesNotFreezeTransientProviderValue(t *testing.T) {
+	calls := 0
+	root := New()
+	root.MapProvider(func() *Address {
+		calls++
+		return NewAddress("广东", "深圳", "宝安", "新安")
+	}, WithScope(Transient))
+	fn := func(addr *Address) *Address { return addr }
+
+	plan, err := root.Plan(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	first, err := plan.Call(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := plan.Call(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if calls != 2 {
+		t.Errorf("expected Plan.Call to invoke the transient provider once per call, got %d calls", calls)
+	}
+	if first[0].Interface() == second[0].Interface() {
+		t.Errorf("expected Plan.Call to resolve distinct instances from a transient provider")
+	}
+}
+
+//go:noinline
+func makeGreeter(name string) func(auth *AuthCode) string {
+	return func(auth *AuthCode) string {
+		return name + ":" + auth.Code
+	}
+}
+
+func TestPlanDistinguishesClosureInstances(t *testing.T) {
+	root := New()
+	root.Map(&AuthCode{Code: "xyz"})
+
+	alicePlan, err := root.Plan(makeGreeter("alice"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	bobPlan, err := root.Plan(makeGreeter("bob"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := bobPlan.Call(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := out[0].String(); got != "bob:xyz" {
+		t.Errorf("expected bobPlan to call the bob closure, got %q", got)
+	}
+
+	out, err = alicePlan.Call(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := out[0].String(); got != "alice:xyz" {
+		t.Errorf("expected alicePlan to call the alice closure, got %q", got)
+	}
+}
+
+func TestPlanIsCachedByFuncPointer(t *testing.T) {
+	root := New()
+	root.Map(&AuthCode{Code: "123456"})
+	fn := func(auth *AuthCode) {}
+
+	first, err := root.Plan(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := root.Plan(fn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first != second {
+		t.Errorf("expected repeated Plan(fn) calls to return the cached plan")
+	}
+}
+
+func TestInvokeDoesNotLeakPlansCache(t *testing.T) {
+	root := New().(*injector)
+	root.Map(&AuthCode{Code: "123456"})
+
+	for i := 0; i < 50; i++ {
+		fn := func(auth *AuthCode) {}
+		if _, err := root.Invoke(fn); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	root.mu.RLock()
+	n := len(root.plans)
+	root.mu.RUnlock()
+	if n != 0 {
+		t.Errorf("expected Invoke not to cache per-call closures in plans, got %d cached entries", n)
+	}
+}
+
+type B struct {
+	C *C `inject`
+}
+
+type C struct {
+	Code *AuthCode `inject`
+}
+
+func TestAutoApplyBuildsChain(t *testing.T) {
+	injector := New(WithAutoApply())
+	injector.Map(&AuthCode{Code: "123456"})
+
+	var b B
+	if err := injector.Apply(&b); err != nil {
+		t.Fatal(err)
+	}
+	if b.C == nil || b.C.Code == nil || b.C.Code.Code != "123456" {
+		t.Errorf("expected AutoApply to build the B->C->AuthCode chain, got %+v", b)
+	}
+}
+
+func TestAutoApplyCachesInstance(t *testing.T) {
+	injector := New(WithAutoApply())
+	injector.Map(&AuthCode{Code: "123456"})
+
+	first := injector.Get(reflect.TypeOf(&C{}))
+	second := injector.Get(reflect.TypeOf(&C{}))
+	if first.Interface() != second.Interface() {
+		t.Errorf("expected AutoApply to cache the auto-built instance")
+	}
+}
+
+// TestAutoApplyConcurrentFirstUseBuildsOnce 用 -race 运行以验证同一类型的并发首次
+// Get 只会构建出一个实例, 而不是各自构建一份再互相覆盖 values.
+func TestAutoApplyConcurrentFirstUseBuildsOnce(t *testing.T) {
+	injector := New(WithAutoApply())
+	injector.Map(&AuthCode{Code: "123456"})
+
+	results := make([]interface{}, 30)
+	var wg sync.WaitGroup
+	for i := 0; i < len(results); i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = injector.Get(reflect.TypeOf(&C{})).Interface()
+		}()
+	}
+	wg.Wait()
+
+	first := results[0]
+	for i, v := range results {
+		if v != first {
+			t.Errorf("expected all concurrent Get calls to share the same auto-built instance, result[%d] differs", i)
+		}
+	}
+}
+
+type Dep struct {
+	Tag string
+}
+
+type NeedsDep struct {
+	D *Dep `inject`
+}
+
+func TestAutoApplyOnChildUsesChildOwnBindings(t *testing.T) {
+	root := New(WithAutoApply())
+	root.Map(&Dep{Tag: "root"})
+	child := root.Child()
+	child.Map(&Dep{Tag: "A"})
+
+	val := child.Get(reflect.TypeOf(&NeedsDep{}))
+	needsDep := val.Interface().(*NeedsDep)
+	if needsDep.D.Tag != "A" {
+		t.Errorf("expected AutoApply on child to resolve the child's own *Dep override, got %q", needsDep.D.Tag)
+	}
+}
+
+func TestAutoApplyOnChildDoesNotLeakAcrossSiblings(t *testing.T) {
+	root := New(WithAutoApply())
+	root.Map(&Dep{Tag: "root"})
+	childA := root.Child()
+	childA.Map(&Dep{Tag: "A"})
+	childB := root.Child()
+	childB.Map(&Dep{Tag: "B"})
+
+	fromA := childA.Get(reflect.TypeOf(&NeedsDep{})).Interface()
+	fromB := childB.Get(reflect.TypeOf(&NeedsDep{})).Interface()
+	if fromA == fromB {
+		t.Errorf("expected sibling children to each auto-build their own *NeedsDep instance, got the same instance")
+	}
+	if fromA.(*NeedsDep).D.Tag != "A" {
+		t.Errorf("expected childA's auto-built instance to use its own *Dep, got %q", fromA.(*NeedsDep).D.Tag)
+	}
+	if fromB.(*NeedsDep).D.Tag != "B" {
+		t.Errorf("expected childB's auto-built instance to use its own *Dep, got %q", fromB.(*NeedsDep).D.Tag)
+	}
+}
+
+type Self struct {
+	Other *Self `inject`
+}
+
+func TestAutoApplyDetectsCycle(t *testing.T) {
+	injector := New(WithAutoApply())
+
+	var s Self
+	err := injector.Apply(&s)
+	var resErr *ResolutionError
+	if !errors.As(err, &resErr) {
+		t.Fatalf("expected a *ResolutionError, got %v", err)
+	}
+}
+
+type SpecialString string
+
+type Handler interface {
+	Handle() string
+}
+
+type handlerA struct{}
+
+func (handlerA) Handle() string { return "a" }
+
+type handlerB struct{}
+
+func (handlerB) Handle() string { return "b" }
+
+func TestBindUsesGenericTypeAsKey(t *testing.T) {
+	injector := New()
+	Bind[SpecialString](injector, "男")
+
+	val := injector.Get(reflect.TypeOf(SpecialString("")))
+	if val.Interface() != SpecialString("男") {
+		t.Errorf("expected Bind to store the value under type SpecialString, got %v", val)
+	}
+}
+
+func TestGetInterfaceIsDeterministicByInsertionOrder(t *testing.T) {
+	injector := New()
+	injector.Map(handlerB{})
+	injector.Map(handlerA{})
+
+	val := injector.Get(reflect.TypeOf((*Handler)(nil)).Elem())
+	h := val.Interface().(Handler)
+	if h.Handle() != "b" {
+		t.Errorf("expected the first-inserted implementer (handlerB) to win, got %q", h.Handle())
+	}
+}
+
+func TestBindAllReturnsEveryImplementer(t *testing.T) {
+	injector := New()
+	injector.Map(handlerB{})
+	injector.Map(handlerA{})
+
+	handlers := BindAll[Handler](injector)
+	if len(handlers) != 2 {
+		t.Fatalf("expected 2 handlers, got %d", len(handlers))
+	}
+	if handlers[0].Handle() != "b" || handlers[1].Handle() != "a" {
+		t.Errorf("expected handlers in insertion order [b, a], got [%s, %s]", handlers[0].Handle(), handlers[1].Handle())
+	}
+}
+
+func TestBindAllWalksParentChain(t *testing.T) {
+	root := New()
+	root.Map(handlerB{})
+
+	child := root.Child()
+	child.Map(handlerA{})
+
+	handlers := BindAll[Handler](child)
+	if len(handlers) != 2 {
+		t.Fatalf("expected 2 handlers across parent and child, got %d", len(handlers))
+	}
+	if handlers[0].Handle() != "a" || handlers[1].Handle() != "b" {
+		t.Errorf("expected handlers [a (child), b (parent)], got [%s, %s]", handlers[0].Handle(), handlers[1].Handle())
+	}
+}
+
+type namedHandler struct{ name string }
+
+func (h namedHandler) Handle() string { return h.name }
+
+func TestBindAllChildBindingShadowsParent(t *testing.T) {
+	root := New()
+	root.Map(namedHandler{name: "root"})
+
+	child := root.Child()
+	child.Map(namedHandler{name: "child"})
+
+	handlers := BindAll[Handler](child)
+	if len(handlers) != 1 {
+		t.Fatalf("expected child's binding to shadow the parent's same type, got %d handlers", len(handlers))
+	}
+	if handlers[0].Handle() != "child" {
+		t.Errorf("expected the child's namedHandler binding to win, got %q", handlers[0].Handle())
+	}
+}
+
+func BenchmarkInvoke(b *testing.B) {
+	root := New()
+	root.Map(&AuthCode{Code: "123456"})
+	fn := func(auth *AuthCode) {}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := root.Invoke(fn); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkPlanCall(b *testing.B) {
+	root := New()
+	root.Map(&AuthCode{Code: "123456"})
+	fn := func(auth *AuthCode) {}
+
+	plan, err := root.Plan(fn)
+	if err != nil {
+		b.Fatal(err)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := plan.Call(root); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkInvokeParallel(b *testing.B) {
+	root := New()
+	root.Map(&AuthCode{Code: "123456"})
+	root.MapProvider(func(auth *AuthCode) *User {
+		return &User{Name: "request-scoped", AuthCoed: auth}
+	})
+
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			req := root.Child()
+			if _, err := req.Invoke(func(user *User) {}); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}